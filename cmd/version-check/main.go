@@ -0,0 +1,80 @@
+// Command version-check compares the running binary's build metadata
+// against a signed release manifest and exits non-zero if the build is
+// outdated or affected by a known vulnerability, so it can gate a CI or
+// fleet-upgrade job.
+package main
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/yasseen-salama/google-maps-scraper/internal/version"
+	"github.com/yasseen-salama/google-maps-scraper/internal/versioncheck"
+)
+
+// releasePublicKeyHex is the ed25519 public key used to verify the release
+// feed's signature. It is embedded at build time via -ldflags in the same
+// way as internal/version's fields; while it is left empty, the command
+// exits with status 2 before fetching the manifest.
+var releasePublicKeyHex = ""
+
+func main() {
+	feedURL := flag.String("feed-url", "https://releases.example.com/google-maps-scraper/manifest.json", "URL of the signed release manifest")
+	timeout := flag.Duration("timeout", 10*time.Second, "timeout for fetching the release manifest")
+	flag.Parse()
+
+	publicKey, err := decodePublicKey(releasePublicKeyHex)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "version-check:", err)
+		os.Exit(2)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	checker := versioncheck.NewChecker(*feedURL, publicKey, nil)
+	manifest, err := checker.Fetch(ctx)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "version-check:", err)
+		os.Exit(2)
+	}
+
+	info := version.Get()
+	result := versioncheck.Compare(info.GitCommit, info.Version, manifest)
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(result); err != nil {
+		fmt.Fprintln(os.Stderr, "version-check:", err)
+		os.Exit(2)
+	}
+
+	switch result.Status {
+	case versioncheck.StatusVulnerable:
+		os.Exit(3)
+	case versioncheck.StatusOutdated:
+		os.Exit(1)
+	default:
+		os.Exit(0)
+	}
+}
+
+func decodePublicKey(hexKey string) (ed25519.PublicKey, error) {
+	if hexKey == "" {
+		return nil, fmt.Errorf("release public key is not embedded in this build (set via -ldflags -X)")
+	}
+	raw, err := hex.DecodeString(hexKey)
+	if err != nil {
+		return nil, fmt.Errorf("decoding embedded public key: %w", err)
+	}
+	if len(raw) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("embedded public key has wrong length: got %d, want %d", len(raw), ed25519.PublicKeySize)
+	}
+	return ed25519.PublicKey(raw), nil
+}
@@ -0,0 +1,187 @@
+// Package envcfg parses environment variables into a typed Go struct,
+// replacing the ad-hoc getEnvOrDefault(key, default) calls that used to be
+// scattered across the module. A single Load(&cfg) call documents every
+// environment knob the scraper reads in one place, via struct tags:
+//
+//	type Config struct {
+//		Version     string        `env:"VERSION"`
+//		Environment string        `env:"ENVIRONMENT" default:"development"`
+//		Port        int           `env:"PORT" default:"8080"`
+//		RequestTTL  time.Duration `env:"REQUEST_TTL" default:"30s"`
+//		Debug       bool          `env:"DEBUG" default:"false"`
+//		AllowedCORS []string      `env:"ALLOWED_CORS"`
+//		APIBaseURL  *url.URL      `env:"API_BASE_URL" required:"true"`
+//		APIKey      string        `env:"API_KEY" secret:"true"`
+//	}
+//
+//	var cfg Config
+//	if err := envcfg.Load(&cfg); err != nil {
+//		log.Fatal(err)
+//	}
+//
+// All env names are looked up with an optional prefix (see WithPrefix), so
+// a single process can scope its variables as e.g. GMS_PORT.
+package envcfg
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Option configures a Load call.
+type Option func(*options)
+
+type options struct {
+	prefix string
+	lookup func(string) (string, bool)
+}
+
+// WithPrefix scopes every env lookup under prefix, e.g. WithPrefix("GMS_")
+// makes a field tagged `env:"PORT"` read from GMS_PORT.
+func WithPrefix(prefix string) Option {
+	return func(o *options) { o.prefix = prefix }
+}
+
+// WithLookup overrides the source of environment variables. It exists
+// mainly for tests; production callers should rely on the os.LookupEnv
+// default.
+func WithLookup(lookup func(string) (string, bool)) Option {
+	return func(o *options) { o.lookup = lookup }
+}
+
+// Load populates the fields of the struct pointed to by dst from
+// environment variables, using each field's `env`, `default`, and
+// `required` tags. dst must be a non-nil pointer to a struct.
+func Load(dst any, opts ...Option) error {
+	o := options{lookup: os.LookupEnv}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("envcfg: Load requires a non-nil pointer to a struct, got %T", dst)
+	}
+
+	structVal := v.Elem()
+	structType := structVal.Type()
+
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		envTag, ok := field.Tag.Lookup("env")
+		if !ok || envTag == "" {
+			continue
+		}
+
+		key := o.prefix + envTag
+		raw, present := o.lookup(key)
+		if !present || raw == "" {
+			if field.Tag.Get("required") == "true" {
+				return fmt.Errorf("envcfg: required environment variable %s is not set", key)
+			}
+			raw = field.Tag.Get("default")
+			if raw == "" {
+				continue
+			}
+		}
+
+		if err := setField(structVal.Field(i), raw); err != nil {
+			return fmt.Errorf("envcfg: %s: %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+func setField(field reflect.Value, raw string) error {
+	switch v := field.Addr().Interface().(type) {
+	case *string:
+		*v = raw
+		return nil
+	case *bool:
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		*v = parsed
+		return nil
+	case *int:
+		parsed, err := strconv.Atoi(raw)
+		if err != nil {
+			return err
+		}
+		*v = parsed
+		return nil
+	case *time.Duration:
+		parsed, err := time.ParseDuration(raw)
+		if err != nil {
+			return err
+		}
+		*v = parsed
+		return nil
+	case *[]string:
+		*v = splitAndTrim(raw)
+		return nil
+	case **url.URL:
+		parsed, err := url.Parse(raw)
+		if err != nil {
+			return err
+		}
+		*v = parsed
+		return nil
+	default:
+		return fmt.Errorf("unsupported field type %s", field.Type())
+	}
+}
+
+func splitAndTrim(raw string) []string {
+	parts := strings.Split(raw, ",")
+	out := make([]string, 0, len(parts))
+	for _, p := range parts {
+		p = strings.TrimSpace(p)
+		if p != "" {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// Summary renders the resolved fields of a config struct as "KEY=value"
+// lines suitable for a startup log, masking any field tagged
+// `secret:"true"`.
+func Summary(cfg any, opts ...Option) []string {
+	o := options{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	v := reflect.ValueOf(cfg)
+	for v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+	t := v.Type()
+
+	lines := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		envTag, ok := field.Tag.Lookup("env")
+		if !ok || envTag == "" {
+			continue
+		}
+
+		value := fmt.Sprintf("%v", v.Field(i).Interface())
+		if field.Tag.Get("secret") == "true" && value != "" {
+			value = "***"
+		}
+		lines = append(lines, fmt.Sprintf("%s%s=%s", o.prefix, envTag, value))
+	}
+	return lines
+}
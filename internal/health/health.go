@@ -0,0 +1,98 @@
+// Package health implements Kubernetes-style liveness/readiness checks.
+// Packages that depend on an external resource (a database, a worker pool,
+// a headless browser) register a CheckFunc with a Registry; the HTTP
+// handlers in web/handlers run every registered check on each /readyz or
+// /healthz request.
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Status is the outcome of a single check.
+type Status string
+
+const (
+	StatusOK   Status = "ok"
+	StatusFail Status = "fail"
+)
+
+// CheckFunc performs one dependency check. It should respect ctx's deadline
+// and return promptly when it is exceeded.
+type CheckFunc func(ctx context.Context) error
+
+// CheckResult is the outcome of running one registered check.
+type CheckResult struct {
+	Name      string `json:"name"`
+	Status    Status `json:"status"`
+	LatencyMS int64  `json:"latency_ms"`
+	Error     string `json:"error,omitempty"`
+}
+
+// Registry holds the named checks contributed by other packages.
+type Registry struct {
+	mu     sync.RWMutex
+	checks map[string]CheckFunc
+}
+
+// NewRegistry creates an empty check registry.
+func NewRegistry() *Registry {
+	return &Registry{checks: make(map[string]CheckFunc)}
+}
+
+// Register adds a named check. Registering the same name twice overwrites
+// the previous check.
+func (r *Registry) Register(name string, fn CheckFunc) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.checks[name] = fn
+}
+
+// Run executes every registered check concurrently, bounding each one by
+// timeout, and returns a result per check in registration order is not
+// guaranteed - callers that need a stable order should sort the result.
+func (r *Registry) Run(ctx context.Context, timeout time.Duration) []CheckResult {
+	r.mu.RLock()
+	names := make([]string, 0, len(r.checks))
+	fns := make([]CheckFunc, 0, len(r.checks))
+	for name, fn := range r.checks {
+		names = append(names, name)
+		fns = append(fns, fn)
+	}
+	r.mu.RUnlock()
+
+	results := make([]CheckResult, len(names))
+	var wg sync.WaitGroup
+	for i := range names {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = runOne(ctx, names[i], fns[i], timeout)
+		}(i)
+	}
+	wg.Wait()
+
+	return results
+}
+
+func runOne(ctx context.Context, name string, fn CheckFunc, timeout time.Duration) CheckResult {
+	checkCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := fn(checkCtx)
+	latency := time.Since(start)
+
+	result := CheckResult{
+		Name:      name,
+		Status:    StatusOK,
+		LatencyMS: latency.Milliseconds(),
+	}
+	if err != nil {
+		result.Status = StatusFail
+		result.Error = err.Error()
+	}
+	return result
+}
@@ -0,0 +1,105 @@
+// Package version holds build metadata that is injected at compile time via
+// -ldflags (the same pattern used by geth/plugeth). When the binary is built
+// without those flags - e.g. a plain `go build ./...` - the package falls
+// back to the module version and VCS stamps that debug.ReadBuildInfo()
+// reports for the binary. Version stays empty when the build info has only
+// "(devel)" as the module version; Info.String() then prints "unknown".
+package version
+
+import (
+	"fmt"
+	"runtime"
+	"runtime/debug"
+)
+
+// These variables are meant to be set at build time, e.g.:
+//
+//	go build -ldflags "-X github.com/yasseen-salama/google-maps-scraper/internal/version.Version=v1.2.3 \
+//	  -X github.com/yasseen-salama/google-maps-scraper/internal/version.GitCommit=$(git rev-parse HEAD) \
+//	  -X github.com/yasseen-salama/google-maps-scraper/internal/version.GitDate=$(git log -1 --format=%cI) \
+//	  -X github.com/yasseen-salama/google-maps-scraper/internal/version.BuildDate=$(date -u +%Y-%m-%dT%H:%M:%SZ) \
+//	  -X github.com/yasseen-salama/google-maps-scraper/internal/version.BuildTags=$(make_build_tags)"
+//
+// GitDate is the commit date of GitCommit; BuildDate is the wall-clock time
+// the binary was actually compiled, and the two will usually differ. Both
+// are optional: GitDate falls back to the vcs.time setting from
+// debug.ReadBuildInfo(), while BuildDate has no fallback and stays empty.
+// GoVersion is never set via ldflags; it is always read from the runtime.
+var (
+	Version   = ""
+	GitCommit = ""
+	GitDate   = ""
+	BuildDate = ""
+	BuildTags = ""
+)
+
+// Info is the full set of build metadata known about the running binary.
+type Info struct {
+	Version   string
+	GitCommit string
+	GitDate   string
+	BuildDate string
+	GoVersion string
+	BuildTags string
+	OS        string
+	Arch      string
+}
+
+// ShortCommit returns the first 7 characters of GitCommit, or the full
+// string if it is shorter than that.
+func (i Info) ShortCommit() string {
+	if len(i.GitCommit) > 7 {
+		return i.GitCommit[:7]
+	}
+	return i.GitCommit
+}
+
+// String renders a one-line summary suitable for --version output and logs.
+func (i Info) String() string {
+	return fmt.Sprintf("%s (commit %s, committed %s, built %s, %s, %s/%s)",
+		orUnknown(i.Version), orUnknown(i.ShortCommit()), orUnknown(i.GitDate), orUnknown(i.BuildDate), i.GoVersion, i.OS, i.Arch)
+}
+
+// Get returns the build metadata for the running binary. If ldflags were not
+// supplied at build time, it falls back to debug.ReadBuildInfo() so the
+// result is still meaningful for developers running `go build`/`go run`
+// directly.
+func Get() Info {
+	info := Info{
+		Version:   Version,
+		GitCommit: GitCommit,
+		GitDate:   GitDate,
+		BuildDate: BuildDate,
+		BuildTags: BuildTags,
+		GoVersion: runtime.Version(),
+		OS:        runtime.GOOS,
+		Arch:      runtime.GOARCH,
+	}
+
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		if info.Version == "" && bi.Main.Version != "" && bi.Main.Version != "(devel)" {
+			info.Version = bi.Main.Version
+		}
+		for _, setting := range bi.Settings {
+			switch setting.Key {
+			case "vcs.revision":
+				if info.GitCommit == "" {
+					info.GitCommit = setting.Value
+				}
+			case "vcs.time":
+				if info.GitDate == "" {
+					info.GitDate = setting.Value
+				}
+			}
+		}
+	}
+
+	return info
+}
+
+func orUnknown(s string) string {
+	if s == "" {
+		return "unknown"
+	}
+	return s
+}
@@ -0,0 +1,189 @@
+// Package versioncheck compares the running build against a signed
+// release manifest so operators (and the fleet itself) can tell whether an
+// instance is current, outdated, or running a build with a known
+// vulnerability.
+package versioncheck
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Status describes how the running build compares to the release feed.
+type Status string
+
+const (
+	StatusOK         Status = "ok"
+	StatusOutdated   Status = "outdated"
+	StatusVulnerable Status = "vulnerable"
+)
+
+// Release describes the latest published build.
+type Release struct {
+	Version      string `json:"version"`
+	Commit       string `json:"commit"`
+	Date         string `json:"date"`
+	MinSupported string `json:"min_supported"`
+}
+
+// Advisory flags a range of commits affected by a known vulnerability.
+type Advisory struct {
+	CommitRange string `json:"commit_range"`
+	CVE         string `json:"cve"`
+	Severity    string `json:"severity"`
+}
+
+// Manifest is the JSON document published at the feed URL, signed as
+// described on signedEnvelope.
+type Manifest struct {
+	Latest     Release    `json:"latest"`
+	Vulnerable []Advisory `json:"vulnerable"`
+}
+
+// signedEnvelope is the actual wire format returned by the feed: the
+// manifest payload plus an ed25519 signature computed over the raw
+// Manifest bytes.
+type signedEnvelope struct {
+	Manifest  json.RawMessage `json:"manifest"`
+	Signature []byte          `json:"signature"`
+}
+
+// Result is the outcome of comparing the running build against a fetched
+// Manifest.
+type Result struct {
+	Status     Status     `json:"status"`
+	Current    string     `json:"current"`
+	Latest     string     `json:"latest"`
+	Advisories []Advisory `json:"advisories,omitempty"`
+}
+
+// Checker fetches and verifies a release manifest, then compares it
+// against a running build's commit.
+type Checker struct {
+	feedURL   string
+	publicKey ed25519.PublicKey
+	client    *http.Client
+}
+
+// NewChecker creates a Checker that fetches manifests from feedURL and
+// verifies them against publicKey. client defaults to http.DefaultClient
+// when nil.
+func NewChecker(feedURL string, publicKey ed25519.PublicKey, client *http.Client) *Checker {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Checker{feedURL: feedURL, publicKey: publicKey, client: client}
+}
+
+// Fetch retrieves the manifest from the feed URL and verifies its ed25519
+// signature before returning it, so the result can't be spoofed by a
+// man-in-the-middle that doesn't hold the feed's private key.
+func (c *Checker) Fetch(ctx context.Context) (Manifest, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.feedURL, nil)
+	if err != nil {
+		return Manifest{}, fmt.Errorf("versioncheck: building request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return Manifest{}, fmt.Errorf("versioncheck: fetching manifest: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return Manifest{}, fmt.Errorf("versioncheck: feed returned status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return Manifest{}, fmt.Errorf("versioncheck: reading response: %w", err)
+	}
+
+	var envelope signedEnvelope
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return Manifest{}, fmt.Errorf("versioncheck: decoding envelope: %w", err)
+	}
+
+	if !ed25519.Verify(c.publicKey, envelope.Manifest, envelope.Signature) {
+		return Manifest{}, fmt.Errorf("versioncheck: manifest signature verification failed")
+	}
+
+	var manifest Manifest
+	if err := json.Unmarshal(envelope.Manifest, &manifest); err != nil {
+		return Manifest{}, fmt.Errorf("versioncheck: decoding manifest: %w", err)
+	}
+
+	return manifest, nil
+}
+
+// Compare evaluates currentCommit against a fetched Manifest and reports
+// whether the running build is current, outdated, or affected by a known
+// vulnerability. A commit listed in Manifest.Vulnerable always yields
+// StatusVulnerable, even if it otherwise matches the latest release.
+func Compare(currentCommit, currentVersion string, manifest Manifest) Result {
+	result := Result{
+		Status:  StatusOK,
+		Current: currentVersion,
+		Latest:  manifest.Latest.Version,
+	}
+
+	for _, advisory := range manifest.Vulnerable {
+		if commitInRange(currentCommit, advisory.CommitRange) {
+			result.Advisories = append(result.Advisories, advisory)
+		}
+	}
+	if len(result.Advisories) > 0 {
+		result.Status = StatusVulnerable
+		return result
+	}
+
+	if currentCommit != manifest.Latest.Commit {
+		result.Status = StatusOutdated
+	}
+	return result
+}
+
+// commitInRange reports whether commit is covered by an advisory's
+// commit_range. Three forms are supported:
+//
+//   - a single commit: "abc1234" matches only that exact commit.
+//   - a comma-separated list: "abc1234,def5678" matches any commit in the
+//     list.
+//   - a two-endpoint range: "abc1234..def5678" matches exactly those two
+//     endpoint commits.
+//
+// commitInRange does not resolve git ancestry, so a "from..to" range only
+// ever matches its two endpoints, not every commit between them.
+// Advisories should enumerate affected commits explicitly (via the list
+// form) to get full coverage; this keeps the check correct for the data
+// it's actually given instead of silently missing commits in between.
+func commitInRange(commit, commitRange string) bool {
+	if commitRange == "" || commit == "" {
+		return false
+	}
+
+	for _, entry := range strings.Split(commitRange, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		if from, to, ok := strings.Cut(entry, ".."); ok {
+			if commit == from || commit == to {
+				return true
+			}
+			continue
+		}
+
+		if commit == entry {
+			return true
+		}
+	}
+
+	return false
+}
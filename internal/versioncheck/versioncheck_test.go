@@ -0,0 +1,63 @@
+package versioncheck
+
+import "testing"
+
+func TestCommitInRange(t *testing.T) {
+	tests := []struct {
+		name        string
+		commit      string
+		commitRange string
+		want        bool
+	}{
+		{name: "exact match", commit: "abc1234", commitRange: "abc1234", want: true},
+		{name: "exact mismatch", commit: "abc1234", commitRange: "def5678", want: false},
+		{name: "list match", commit: "def5678", commitRange: "abc1234,def5678", want: true},
+		{name: "list mismatch", commit: "ffffff", commitRange: "abc1234,def5678", want: false},
+		{name: "range start endpoint", commit: "abc1234", commitRange: "abc1234..def5678", want: true},
+		{name: "range end endpoint", commit: "def5678", commitRange: "abc1234..def5678", want: true},
+		{name: "range interior commit not covered", commit: "c0ffee1", commitRange: "abc1234..def5678", want: false},
+		{name: "empty range", commit: "abc1234", commitRange: "", want: false},
+		{name: "empty commit", commit: "", commitRange: "abc1234", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := commitInRange(tt.commit, tt.commitRange); got != tt.want {
+				t.Errorf("commitInRange(%q, %q) = %v, want %v", tt.commit, tt.commitRange, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	manifest := Manifest{
+		Latest: Release{Version: "v1.2.0", Commit: "latestcommit"},
+		Vulnerable: []Advisory{
+			{CommitRange: "badcommit1,badcommit2", CVE: "CVE-2026-0001", Severity: "high"},
+		},
+	}
+
+	t.Run("current release is ok", func(t *testing.T) {
+		result := Compare("latestcommit", "v1.2.0", manifest)
+		if result.Status != StatusOK {
+			t.Errorf("Status = %v, want %v", result.Status, StatusOK)
+		}
+	})
+
+	t.Run("older commit is outdated", func(t *testing.T) {
+		result := Compare("oldcommit", "v1.1.0", manifest)
+		if result.Status != StatusOutdated {
+			t.Errorf("Status = %v, want %v", result.Status, StatusOutdated)
+		}
+	})
+
+	t.Run("advisory commit is vulnerable", func(t *testing.T) {
+		result := Compare("badcommit2", "v1.1.0", manifest)
+		if result.Status != StatusVulnerable {
+			t.Errorf("Status = %v, want %v", result.Status, StatusVulnerable)
+		}
+		if len(result.Advisories) != 1 || result.Advisories[0].CVE != "CVE-2026-0001" {
+			t.Errorf("Advisories = %+v, want the CVE-2026-0001 advisory", result.Advisories)
+		}
+	})
+}
@@ -0,0 +1,85 @@
+package adminv1
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	adminv1pb "github.com/yasseen-salama/google-maps-scraper/api/proto/adminv1"
+)
+
+// RegisterGateway mounts a REST mirror of AdminService on mux, calling
+// server directly rather than over the network (the process hosts both
+// the gRPC server and the REST mux in one binary, so there is no need for
+// a loopback grpc.ClientConn).
+//
+// This is hand-written, not generated: a real protoc-gen-grpc-gateway run
+// needs the "google/api/annotations.proto" + "google/api/http.proto"
+// includes from googleapis, which aren't vendored in this repo, and
+// faking protoc-gen-grpc-gateway's output by hand produced code that
+// didn't compile against any real grpc-gateway release. The routes below
+// are written by hand; nothing regenerates them when admin.proto changes.
+func RegisterGateway(mux *http.ServeMux, server adminv1pb.AdminServiceServer) {
+	mux.HandleFunc("/version", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		resp, err := server.GetVersion(r.Context(), &adminv1pb.GetVersionRequest{})
+		writeJSON(w, resp, err)
+	})
+
+	mux.HandleFunc("/v1/jobs", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var req adminv1pb.SubmitJobRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "invalid request body", http.StatusBadRequest)
+			return
+		}
+		resp, err := server.SubmitJob(r.Context(), &req)
+		writeJSON(w, resp, err)
+	})
+
+	mux.HandleFunc("/v1/jobs/", func(w http.ResponseWriter, r *http.Request) {
+		jobID := strings.TrimPrefix(r.URL.Path, "/v1/jobs/")
+		if jobID == "" {
+			http.Error(w, "missing job_id", http.StatusBadRequest)
+			return
+		}
+
+		if cancelID, ok := strings.CutSuffix(jobID, ":cancel"); ok {
+			if r.Method != http.MethodPost {
+				http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			resp, err := server.CancelJob(r.Context(), &adminv1pb.CancelJobRequest{JobId: cancelID})
+			writeJSON(w, resp, err)
+			return
+		}
+
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		resp, err := server.GetJobStatus(r.Context(), &adminv1pb.GetJobStatusRequest{JobId: jobID})
+		writeJSON(w, resp, err)
+	})
+}
+
+// writeJSON writes resp as JSON, or translates a non-nil err into a 500.
+// AdminService's handlers don't yet distinguish error kinds (not-found,
+// invalid-argument, ...), so every error maps to StatusInternalServerError
+// for now; that can grow a status.FromError mapping once it needs to.
+func writeJSON(w http.ResponseWriter, resp interface{}, err error) {
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,16 @@
+// Package adminv1 hosts the gRPC server implementation for AdminService,
+// defined in api/proto/adminv1/admin.proto, plus a hand-written REST
+// mirror in gateway.go. The generated message and stub types
+// (adminv1pb.AdminServiceServer, adminv1pb.GetVersionRequest, ...) are
+// produced by running:
+//
+//	go generate ./rpc/adminv1/...
+//
+// which requires protoc, protoc-gen-go, and protoc-gen-go-grpc on PATH.
+// There is deliberately no grpc-gateway step: that would need the
+// "google/api/annotations.proto" + "google/api/http.proto" includes from
+// googleapis, which this repo doesn't vendor, so the REST mirror is
+// hand-written instead (see gateway.go).
+package adminv1
+
+//go:generate protoc -I ../.. --go_out=../.. --go_opt=paths=source_relative --go-grpc_out=../.. --go-grpc_opt=paths=source_relative ../../api/proto/adminv1/admin.proto
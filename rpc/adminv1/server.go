@@ -0,0 +1,51 @@
+package adminv1
+
+import (
+	"context"
+
+	adminv1pb "github.com/yasseen-salama/google-maps-scraper/api/proto/adminv1"
+	"github.com/yasseen-salama/google-maps-scraper/internal/version"
+)
+
+// Server implements adminv1pb.AdminServiceServer. GetVersion is the only
+// RPC with real behavior today; SubmitJob/GetJobStatus/CancelJob exist so
+// the proto surface is stable while the job-management backend is wired
+// in.
+type Server struct {
+	adminv1pb.UnimplementedAdminServiceServer
+
+	environment  string
+	internalView bool
+}
+
+// NewServer creates an admin gRPC server. environment is reported in
+// GetVersion responses; internalView controls whether the full build
+// metadata (go_version, build_tags, os, arch, full git_commit) is
+// returned, mirroring web/handlers.VersionView.
+func NewServer(environment string, internalView bool) *Server {
+	return &Server{environment: environment, internalView: internalView}
+}
+
+// GetVersion mirrors web/handlers.VersionHandler.GetVersion so HTTP, gRPC
+// and REST-mirror (gateway.go) callers see identical build metadata.
+func (s *Server) GetVersion(ctx context.Context, req *adminv1pb.GetVersionRequest) (*adminv1pb.GetVersionResponse, error) {
+	info := version.Get()
+
+	resp := &adminv1pb.GetVersionResponse{
+		Version:     info.Version,
+		BuildDate:   info.BuildDate,
+		GitCommit:   info.ShortCommit(),
+		Environment: s.environment,
+	}
+
+	if s.internalView {
+		resp.GitCommit = info.GitCommit
+		resp.GitDate = info.GitDate
+		resp.GoVersion = info.GoVersion
+		resp.BuildTags = info.BuildTags
+		resp.Os = info.OS
+		resp.Arch = info.Arch
+	}
+
+	return resp, nil
+}
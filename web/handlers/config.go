@@ -0,0 +1,31 @@
+package handlers
+
+import "github.com/yasseen-salama/google-maps-scraper/internal/envcfg"
+
+// Config is the set of environment-driven knobs the handlers package reads.
+// Load it once at startup with envcfg.Load(&cfg) and pass the result to the
+// constructors below, rather than calling os.Getenv from inside a handler.
+type Config struct {
+	Environment  string `env:"ENVIRONMENT" default:"development"`
+	InternalView bool   `env:"VERSION_INTERNAL_VIEW" default:"false"`
+}
+
+// LoadConfig parses Config from the process environment using envcfg.
+func LoadConfig(opts ...envcfg.Option) (Config, error) {
+	var cfg Config
+	if err := envcfg.Load(&cfg, opts...); err != nil {
+		return Config{}, err
+	}
+	return cfg, nil
+}
+
+// NewVersionHandlerFromConfig builds a VersionHandler from a loaded Config,
+// choosing VersionViewInternal or VersionViewPublic based on
+// cfg.InternalView.
+func NewVersionHandlerFromConfig(cfg Config) *VersionHandler {
+	view := VersionViewPublic
+	if cfg.InternalView {
+		view = VersionViewInternal
+	}
+	return NewVersionHandler(view, cfg.Environment)
+}
@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/yasseen-salama/google-maps-scraper/internal/health"
+	"github.com/yasseen-salama/google-maps-scraper/internal/version"
+)
+
+// defaultCheckTimeout is the deadline set on the context passed to each
+// registered check. The check itself must honour it: a check that returns
+// an error (such as the context's deadline error) is reported as failed,
+// and one that ignores the deadline runs until it returns.
+const defaultCheckTimeout = 2 * time.Second
+
+// HealthResponse is returned by /readyz and /healthz.
+type HealthResponse struct {
+	Status  health.Status        `json:"status"`
+	Checks  []health.CheckResult `json:"checks"`
+	Version *VersionResponse     `json:"version,omitempty"`
+}
+
+// HealthHandler serves /livez, /readyz, and /healthz. /livez always
+// succeeds once the process is up; /readyz and /healthz run every check
+// registered in Registry and fail with 503 if any of them does.
+type HealthHandler struct {
+	registry       *health.Registry
+	versionHandler *VersionHandler
+}
+
+// NewHealthHandler creates a health handler backed by registry. versionInfo
+// is used to embed build metadata into /healthz responses; pass nil to omit
+// it.
+func NewHealthHandler(registry *health.Registry, versionInfo *VersionHandler) *HealthHandler {
+	return &HealthHandler{registry: registry, versionHandler: versionInfo}
+}
+
+// Livez reports that the process is running. It never runs dependency
+// checks, so it stays fast even when a downstream dependency is wedged.
+func (h *HealthHandler) Livez(w http.ResponseWriter, r *http.Request) {
+	writeHealth(w, http.StatusOK, HealthResponse{Status: health.StatusOK, Checks: nil})
+}
+
+// Readyz runs every registered check and reports whether the instance is
+// ready to receive traffic.
+func (h *HealthHandler) Readyz(w http.ResponseWriter, r *http.Request) {
+	results := h.registry.Run(r.Context(), defaultCheckTimeout)
+	status, code := overallStatus(results)
+	writeHealth(w, code, HealthResponse{Status: status, Checks: results})
+}
+
+// Healthz runs every registered check like Readyz, and additionally embeds
+// build version info so a single probe answers both "am I up" and "what
+// build am I".
+func (h *HealthHandler) Healthz(w http.ResponseWriter, r *http.Request) {
+	results := h.registry.Run(r.Context(), defaultCheckTimeout)
+	status, code := overallStatus(results)
+
+	response := HealthResponse{Status: status, Checks: results}
+	if h.versionHandler != nil {
+		info := version.Get()
+		response.Version = &VersionResponse{
+			Version:   info.Version,
+			BuildDate: info.BuildDate,
+			GitCommit: info.ShortCommit(),
+		}
+		if h.versionHandler.view == VersionViewInternal {
+			response.Version.GitCommit = info.GitCommit
+			response.Version.GitDate = info.GitDate
+			response.Version.GoVersion = info.GoVersion
+			response.Version.BuildTags = info.BuildTags
+			response.Version.OS = info.OS
+			response.Version.Arch = info.Arch
+		}
+		response.Version.Environment = h.versionHandler.environment
+	}
+
+	writeHealth(w, code, response)
+}
+
+func overallStatus(results []health.CheckResult) (health.Status, int) {
+	for _, result := range results {
+		if result.Status == health.StatusFail {
+			return health.StatusFail, http.StatusServiceUnavailable
+		}
+	}
+	return health.StatusOK, http.StatusOK
+}
+
+func writeHealth(w http.ResponseWriter, code int, response HealthResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
@@ -3,43 +3,82 @@ package handlers
 import (
 	"encoding/json"
 	"net/http"
-	"os"
+
+	"github.com/yasseen-salama/google-maps-scraper/internal/version"
+)
+
+// VersionView selects how much build metadata VersionHandler discloses.
+type VersionView int
+
+const (
+	// VersionViewPublic exposes only what is safe for an unauthenticated
+	// caller: short commit, no Go version, no build tags. This is the
+	// default view.
+	VersionViewPublic VersionView = iota
+	// VersionViewInternal exposes the full build metadata (full commit,
+	// Go version, build tags, os/arch). Routes using this view must sit
+	// behind auth middleware.
+	VersionViewInternal
 )
 
 // VersionResponse contains build metadata and runtime information.
-// Fields are carefully selected to balance debugging utility with security.
-// Full git_commit and go_version are excluded to prevent targeted exploits.
+// Which fields are populated depends on the VersionHandler's configured
+// VersionView: VersionViewPublic redacts git_commit down to 7 characters
+// and omits git_date/go_version/build_tags/os/arch; VersionViewInternal
+// returns everything. build_date (the wall-clock build time) is distinct
+// from git_date (the commit date of git_commit) and is included in both
+// views; it is empty when BuildDate was not set via -ldflags.
 type VersionResponse struct {
-	Version        string `json:"version"`
-	BuildDate      string `json:"build_date"`
-	GitCommitShort string `json:"git_commit_short"`
-	Environment    string `json:"environment"`
+	Version     string `json:"version"`
+	BuildDate   string `json:"build_date"`
+	GitCommit   string `json:"git_commit"`
+	GitDate     string `json:"git_date,omitempty"`
+	GoVersion   string `json:"go_version,omitempty"`
+	BuildTags   string `json:"build_tags,omitempty"`
+	OS          string `json:"os,omitempty"`
+	Arch        string `json:"arch,omitempty"`
+	Environment string `json:"environment"`
 }
 
 // VersionHandler handles version information requests.
-type VersionHandler struct{}
+type VersionHandler struct {
+	view        VersionView
+	environment string
+}
 
-// NewVersionHandler creates a new version handler instance.
-func NewVersionHandler() *VersionHandler {
-	return &VersionHandler{}
+// NewVersionHandler creates a new version handler instance. view controls
+// how much build metadata is disclosed; environment is reported verbatim
+// (e.g. "production", "staging"), and an empty environment is reported as
+// "development".
+func NewVersionHandler(view VersionView, environment string) *VersionHandler {
+	if environment == "" {
+		environment = "development"
+	}
+	return &VersionHandler{view: view, environment: environment}
 }
 
-// GetVersion returns build metadata as JSON.
-// This endpoint does not require authentication.
-// Exposes: version, build_date, git_commit_short (7 chars), environment.
-// Excludes: full git_commit (source targeting), go_version (CVE exploits).
+// GetVersion returns build metadata as JSON. The amount of detail depends
+// on the handler's VersionView: VersionViewPublic (suitable for an
+// unauthenticated route) returns a short commit hash and omits go_version
+// and build_tags; VersionViewInternal, intended to sit behind auth
+// middleware, returns the full internal/version.Info.
 func (h *VersionHandler) GetVersion(w http.ResponseWriter, r *http.Request) {
-	gitCommit := getEnvOrDefault("GIT_COMMIT", "")
-	shortCommit := gitCommit
-	if len(gitCommit) > 7 {
-		shortCommit = gitCommit[:7]
-	}
+	info := version.Get()
 
 	response := VersionResponse{
-		Version:        getEnvOrDefault("VERSION", ""),
-		BuildDate:      getEnvOrDefault("BUILD_DATE", ""),
-		GitCommitShort: shortCommit,
-		Environment:    getEnvOrDefault("ENVIRONMENT", "development"),
+		Version:     info.Version,
+		BuildDate:   info.BuildDate,
+		GitCommit:   info.ShortCommit(),
+		Environment: h.environment,
+	}
+
+	if h.view == VersionViewInternal {
+		response.GitCommit = info.GitCommit
+		response.GitDate = info.GitDate
+		response.GoVersion = info.GoVersion
+		response.BuildTags = info.BuildTags
+		response.OS = info.OS
+		response.Arch = info.Arch
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -48,11 +87,3 @@ func (h *VersionHandler) GetVersion(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 }
-
-// getEnvOrDefault retrieves environment variable or returns default value.
-func getEnvOrDefault(key, defaultValue string) string {
-	if value := os.Getenv(key); value != "" {
-		return value
-	}
-	return defaultValue
-}
@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"crypto/ed25519"
+	"encoding/json"
+	"net/http"
+
+	"github.com/yasseen-salama/google-maps-scraper/internal/version"
+	"github.com/yasseen-salama/google-maps-scraper/internal/versioncheck"
+)
+
+// VersionCheckHandler serves GET /version/check: it fetches the signed
+// release manifest from a configured feed and reports whether the running
+// build is current, outdated, or vulnerable.
+type VersionCheckHandler struct {
+	checker *versioncheck.Checker
+}
+
+// NewVersionCheckHandler creates a handler that checks the running build
+// against the manifest published at feedURL, verified with publicKey.
+func NewVersionCheckHandler(feedURL string, publicKey ed25519.PublicKey) *VersionCheckHandler {
+	return &VersionCheckHandler{checker: versioncheck.NewChecker(feedURL, publicKey, nil)}
+}
+
+// GetVersionCheck fetches the release manifest and compares it against the
+// running build, returning a versioncheck.Result as JSON. A feed fetch or
+// signature failure is reported as 502, since it reflects an upstream
+// problem rather than a bad request.
+func (h *VersionCheckHandler) GetVersionCheck(w http.ResponseWriter, r *http.Request) {
+	info := version.Get()
+
+	manifest, err := h.checker.Fetch(r.Context())
+	if err != nil {
+		http.Error(w, "Failed to fetch release manifest: "+err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	result := versioncheck.Compare(info.GitCommit, info.Version, manifest)
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}